@@ -0,0 +1,123 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StringExtended converts the period to the ISO 8601 "alternative" duration
+// form P[YYYY]-[MM]-[DD]T[hh]:[mm]:[ss], as required by some XML/EDI
+// schemas and by CalDAV/vCard integrations. Unlike String, this always
+// zero-pads every field to its mandated width (4-2-2 for the date, 2-2-2
+// for the time) and omits the T section entirely when all time fields are
+// zero. A leading "-" is emitted for negative periods, as with String.
+func (period Period) StringExtended() string {
+	if period.IsZero() {
+		return "P0000-00-00"
+	}
+
+	sign := ""
+	if period.Sign() < 0 {
+		sign = "-"
+		period = period.Negate()
+	}
+
+	years, months := period.unpackYM()
+	days := absFloat1000(period.mdays)
+
+	date := fmt.Sprintf("P%04d-%02d-%02d", years, months/1000, int(days))
+
+	if period.mseconds == 0 {
+		return sign + date
+	}
+
+	hours, minutes, seconds := period.unpackHMS()
+
+	var secField string
+	if seconds%1000 != 0 {
+		secField = fmt.Sprintf("%06.3f", absFloat1000(seconds))
+	} else {
+		secField = fmt.Sprintf("%02d", seconds/1000)
+	}
+
+	return fmt.Sprintf("%s%sT%02d:%02d:%s", sign, date, hours, minutes, secField)
+}
+
+// ParseExtended parses a period given in the ISO 8601 "alternative" duration
+// form P[YYYY]-[MM]-[DD]T[hh]:[mm]:[ss], as emitted by StringExtended. It
+// complements Parse, which only accepts the designator form.
+func ParseExtended(input string) (Period, error) {
+	original := input
+
+	negative := false
+	if strings.HasPrefix(input, "-") {
+		negative = true
+		input = input[1:]
+	}
+
+	if input == "" || input[0] != 'P' {
+		return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: missing leading 'P'", original)
+	}
+
+	rest := input[1:]
+	datePart := rest
+	timePart := ""
+	if idx := strings.IndexByte(rest, 'T'); idx >= 0 {
+		datePart = rest[:idx]
+		timePart = rest[idx+1:]
+	}
+
+	dateFields := strings.Split(datePart, "-")
+	if len(dateFields) != 3 {
+		return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: expected YYYY-MM-DD", original)
+	}
+
+	years, err := strconv.Atoi(dateFields[0])
+	if err != nil {
+		return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad year: %w", original, err)
+	}
+	months, err := strconv.Atoi(dateFields[1])
+	if err != nil {
+		return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad month: %w", original, err)
+	}
+	days, err := strconv.Atoi(dateFields[2])
+	if err != nil {
+		return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad day: %w", original, err)
+	}
+
+	hours, minutes, milliseconds := 0, 0, 0
+	if timePart != "" {
+		timeFields := strings.Split(timePart, ":")
+		if len(timeFields) != 3 {
+			return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: expected hh:mm:ss", original)
+		}
+		hours, err = strconv.Atoi(timeFields[0])
+		if err != nil {
+			return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad hour: %w", original, err)
+		}
+		minutes, err = strconv.Atoi(timeFields[1])
+		if err != nil {
+			return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad minute: %w", original, err)
+		}
+		seconds, err := strconv.ParseFloat(timeFields[2], 64)
+		if err != nil {
+			return Period{}, fmt.Errorf("period: %q is not a valid ISO-8601 extended duration: bad second: %w", original, err)
+		}
+		milliseconds = int(seconds*1000 + 0.5)
+	}
+
+	period := Period{
+		mmonths:  (years*12 + months) * 1000,
+		mdays:    days * 1000,
+		mseconds: ((hours*60+minutes)*60)*1000 + milliseconds,
+	}
+	if negative {
+		period = period.Negate()
+	}
+	return period, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "testing"
+
+func TestStringExtended(t *testing.T) {
+	cases := []struct {
+		period Period
+		want   string
+	}{
+		{Period{}, "P0000-00-00"},
+		{MustParse("P1Y2M15D"), "P0001-02-15"},
+		{MustParse("P1Y2M15DT4H30M"), "P0001-02-15T04:30:00"},
+		{MustParse("PT1H2M3S"), "P0000-00-00T01:02:03"},
+		{MustParse("P1Y2M15DT4H30M").Negate(), "-P0001-02-15T04:30:00"},
+	}
+
+	for _, c := range cases {
+		got := c.period.StringExtended()
+		if got != c.want {
+			t.Errorf("StringExtended() on %v = %q, want %q", c.period, got, c.want)
+		}
+	}
+}
+
+func TestParseExtendedRoundTrip(t *testing.T) {
+	inputs := []string{
+		"P0000-00-00",
+		"P0001-02-15",
+		"P0001-02-15T04:30:00",
+		"-P0001-02-15T04:30:00",
+		"P0000-00-00T01:02:03",
+	}
+
+	for _, s := range inputs {
+		p, err := ParseExtended(s)
+		if err != nil {
+			t.Errorf("ParseExtended(%q) returned error: %v", s, err)
+			continue
+		}
+		if got := p.StringExtended(); got != s {
+			t.Errorf("ParseExtended(%q).StringExtended() = %q, want %q", s, got, s)
+		}
+	}
+}
+
+func TestParseExtendedRejectsMalformedInput(t *testing.T) {
+	bad := []string{
+		"",
+		"0001-02-15",
+		"P0001-02",
+		"P0001-02-15T04:30",
+	}
+
+	for _, s := range bad {
+		if _, err := ParseExtended(s); err == nil {
+			t.Errorf("ParseExtended(%q) expected an error, got nil", s)
+		}
+	}
+}
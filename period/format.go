@@ -18,6 +18,16 @@ func (period Period) Format() string {
 
 // FormatWithPeriodNames converts the period to human-readable form in a localisable way.
 func (period Period) FormatWithPeriodNames(yearNames, monthNames, weekNames, dayNames, hourNames, minNames, secNames plural.Plurals) string {
+	parts := period.collectParts(yearNames, monthNames, weekNames, dayNames, hourNames, minNames, secNames)
+	return strings.Join(parts, ", ")
+}
+
+// collectParts renders each non-zero unit using the supplied Plurals tables,
+// in the order year, month, week/day, hour, minute, second. It is shared by
+// FormatWithPeriodNames and FormatWith (except for its Narrow style, which
+// renders abbreviations instead) so the two only differ in how the
+// resulting parts are joined.
+func (period Period) collectParts(yearNames, monthNames, weekNames, dayNames, hourNames, minNames, secNames plural.Plurals) []string {
 	period = period.Abs()
 
 	parts := make([]string, 0)
@@ -29,7 +39,6 @@ func (period Period) FormatWithPeriodNames(yearNames, monthNames, weekNames, day
 		if len(weekNames) > 0 {
 			weeks := period.mdays / 7000
 			mdays := period.mdays % 7000
-			//fmt.Printf("%v %#v - %d %d\n", period, period, weeks, mdays)
 			if weeks > 0 {
 				parts = appendNonBlank(parts, weekNames.FormatInt(int(weeks)))
 			}
@@ -45,7 +54,7 @@ func (period Period) FormatWithPeriodNames(yearNames, monthNames, weekNames, day
 	parts = appendNonBlank(parts, minNames.FormatFloat(absFloat1((period.mseconds%3600000)/60000)))
 	parts = appendNonBlank(parts, secNames.FormatFloat(absFloat1000(period.mseconds%60000)))
 
-	return strings.Join(parts, ", ")
+	return parts
 }
 
 func appendNonBlank(parts []string, s string) []string {
@@ -0,0 +1,50 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "github.com/voltusdev/date/period/localize"
+
+// FormatLocalized converts the period to human-readable form using l for
+// pluralisation, number formatting and list joining. Unlike
+// FormatWithPeriodNames, this correctly handles locales with more than the
+// English "zero/one/many" plural forms, since l is expected to be backed by
+// CLDR plural rules (see the localize package). Units that are zero are
+// omitted, except that a zero period is rendered as zero days.
+func (period Period) FormatLocalized(l localize.Localizer) string {
+	period = period.Abs()
+
+	parts := make([]string, 0)
+	years, months := period.unpackYM()
+	if years != 0 {
+		parts = appendNonBlank(parts, l.Year(float64(years)))
+	}
+	if months != 0 {
+		parts = appendNonBlank(parts, l.Month(float64(months)/1000))
+	}
+
+	if period.mdays > 0 || period.IsZero() {
+		weeks := period.mdays / 7000
+		mdays := period.mdays % 7000
+		if weeks > 0 {
+			parts = appendNonBlank(parts, l.Week(float64(weeks)))
+		}
+		if mdays > 0 || weeks == 0 {
+			parts = appendNonBlank(parts, l.Day(float64(mdays)/1000))
+		}
+	}
+
+	hours, minutes, seconds := period.unpackHMS()
+	if hours != 0 {
+		parts = appendNonBlank(parts, l.Hour(float64(hours)))
+	}
+	if minutes != 0 {
+		parts = appendNonBlank(parts, l.Minute(float64(minutes)))
+	}
+	if seconds != 0 {
+		parts = appendNonBlank(parts, l.Second(float64(seconds)/1000))
+	}
+
+	return l.Join(parts)
+}
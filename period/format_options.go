@@ -0,0 +1,156 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rickb777/plural"
+	"github.com/voltusdev/date/period/localize"
+)
+
+// ListStyle selects how the unit parts produced by Format are joined
+// together.
+type ListStyle int
+
+const (
+	// Comma joins parts with ", ", e.g. "1 year, 2 months, 3 days". This
+	// matches the historical behaviour of FormatWithPeriodNames.
+	Comma ListStyle = iota
+
+	// AndConjunction joins parts as a natural-language list with a
+	// trailing conjunction, e.g. "1 year, 2 months and 3 days". When
+	// FormatOptions.Locale names a registered localize.Localizer, that
+	// locale's CLDR list patterns are used for the joining only; the unit
+	// names still come from YearNames etc. So "fr" gives "1 year, 2 months
+	// et 3 days" with the default names, and "1 an, 2 mois et 3 jours" when
+	// French YearNames etc are supplied.
+	AndConjunction
+
+	// Narrow renders each unit using its CLDR narrow abbreviation (y, mo,
+	// w, d, h, min, s) and joins them with a single space and no
+	// punctuation, e.g. "1y 2mo 3d". Unlike Comma and AndConjunction, this
+	// ignores FormatOptions.YearNames etc, since narrow unit forms don't
+	// pluralise.
+	Narrow
+)
+
+// FormatOptions controls how Period.FormatWith renders and joins the unit
+// parts of a period.
+type FormatOptions struct {
+	// YearNames, MonthNames, WeekNames, DayNames, HourNames, MinuteNames
+	// and SecondNames provide the per-unit pluralisation, as per
+	// FormatWithPeriodNames. Zero-valued fields fall back to the package
+	// defaults (PeriodYearNames etc).
+	YearNames, MonthNames, WeekNames, DayNames, HourNames, MinuteNames, SecondNames plural.Plurals
+
+	// ListStyle selects the joining grammar; ignored if Joiner is set.
+	ListStyle ListStyle
+
+	// Locale, if non-empty and registered via localize.Register, supplies
+	// CLDR list-pattern data for AndConjunction joining. Ignored if Joiner
+	// is set.
+	Locale string
+
+	// Joiner, if set, overrides ListStyle and Locale entirely.
+	Joiner func([]string) string
+}
+
+// FormatWith converts the period to human-readable form using opts to
+// control both the per-unit pluralisation and how the resulting parts are
+// joined together.
+func (period Period) FormatWith(opts FormatOptions) string {
+	if opts.Joiner == nil && opts.ListStyle == Narrow {
+		return strings.Join(period.narrowParts(), " ")
+	}
+
+	yearNames := orDefault(opts.YearNames, PeriodYearNames)
+	monthNames := orDefault(opts.MonthNames, PeriodMonthNames)
+	weekNames := orDefault(opts.WeekNames, PeriodWeekNames)
+	dayNames := orDefault(opts.DayNames, PeriodDayNames)
+	hourNames := orDefault(opts.HourNames, PeriodHourNames)
+	minuteNames := orDefault(opts.MinuteNames, PeriodMinuteNames)
+	secondNames := orDefault(opts.SecondNames, PeriodSecondNames)
+
+	parts := period.collectParts(yearNames, monthNames, weekNames, dayNames, hourNames, minuteNames, secondNames)
+
+	return opts.joiner()(parts)
+}
+
+// narrowParts renders each non-zero unit of the period using its CLDR
+// narrow abbreviation, for FormatOptions{ListStyle: Narrow}.
+func (period Period) narrowParts() []string {
+	period = period.Abs()
+
+	parts := make([]string, 0)
+	years, months := period.unpackYM()
+	parts = appendNonBlank(parts, narrowUnit(absFloat1(years), "y"))
+	parts = appendNonBlank(parts, narrowUnit(absFloat1000(months), "mo"))
+
+	if period.mdays > 0 || period.IsZero() {
+		weeks := period.mdays / 7000
+		mdays := period.mdays % 7000
+		if weeks > 0 {
+			parts = appendNonBlank(parts, narrowUnit(absFloat1(int(weeks)), "w"))
+		}
+		if mdays > 0 || weeks == 0 {
+			parts = appendNonBlank(parts, narrowUnit(absFloat1000(mdays), "d"))
+		}
+	}
+
+	parts = appendNonBlank(parts, narrowUnit(absFloat1(period.mseconds/3600000), "h"))
+	parts = appendNonBlank(parts, narrowUnit(absFloat1((period.mseconds%3600000)/60000), "min"))
+	parts = appendNonBlank(parts, narrowUnit(absFloat1000(period.mseconds%60000), "s"))
+
+	return parts
+}
+
+// narrowUnit formats v with suffix, e.g. narrowUnit(2, "mo") -> "2mo", or
+// "" if v is zero so the caller can omit the unit entirely.
+func narrowUnit(v float32, suffix string) string {
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%g%s", v, suffix)
+}
+
+func orDefault(p, fallback plural.Plurals) plural.Plurals {
+	if p == nil {
+		return fallback
+	}
+	return p
+}
+
+func (opts FormatOptions) joiner() func([]string) string {
+	if opts.Joiner != nil {
+		return opts.Joiner
+	}
+
+	switch opts.ListStyle {
+	case AndConjunction:
+		if l, ok := localize.Lookup(opts.Locale); ok {
+			return l.Join
+		}
+		return joinWithAnd
+
+	default:
+		return func(parts []string) string { return strings.Join(parts, ", ") }
+	}
+}
+
+// joinWithAnd is the English-only fallback for AndConjunction when no
+// locale is registered under FormatOptions.Locale.
+func joinWithAnd(parts []string) string {
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	case 2:
+		return parts[0] + " and " + parts[1]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
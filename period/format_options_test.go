@@ -0,0 +1,67 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "testing"
+
+func TestFormatWithListStyle(t *testing.T) {
+	p := MustParse("P1Y2M3D")
+
+	cases := []struct {
+		style ListStyle
+		want  string
+	}{
+		{Comma, "1 year, 2 months, 3 days"},
+		{AndConjunction, "1 year, 2 months and 3 days"},
+		{Narrow, "1y 2mo 3d"},
+	}
+
+	for _, c := range cases {
+		got := p.FormatWith(FormatOptions{ListStyle: c.style})
+		if got != c.want {
+			t.Errorf("FormatWith(ListStyle: %v) = %q, want %q", c.style, got, c.want)
+		}
+	}
+}
+
+func TestFormatWithNarrowCoversAllUnits(t *testing.T) {
+	p := MustParse("P1W2DT3H4M5S")
+	got := p.FormatWith(FormatOptions{ListStyle: Narrow})
+	want := "1w 2d 3h 4min 5s"
+	if got != want {
+		t.Errorf("FormatWith(ListStyle: Narrow) = %q, want %q", got, want)
+	}
+}
+
+func TestFormatWithCustomJoiner(t *testing.T) {
+	p := MustParse("PT1H30M")
+	got := p.FormatWith(FormatOptions{Joiner: func(parts []string) string {
+		return "[" + joinWithAnd(parts) + "]"
+	}})
+	want := "[1 hour and 30 minutes]"
+	if got != want {
+		t.Errorf("FormatWith(Joiner: ...) = %q, want %q", got, want)
+	}
+}
+
+func TestFormatWithLocale(t *testing.T) {
+	p := MustParse("P1Y2M3D")
+
+	// The "fr" locale's CLDR list pattern is used for joining even though
+	// the unit names themselves come from the default English Plurals
+	// tables, since FormatOptions doesn't override YearNames etc here.
+	got := p.FormatWith(FormatOptions{ListStyle: AndConjunction, Locale: "fr"})
+	want := "1 year, 2 months et 3 days"
+	if got != want {
+		t.Errorf("FormatWith(Locale: \"fr\") = %q, want %q", got, want)
+	}
+
+	// An unregistered locale falls back to the plain English joiner.
+	got = p.FormatWith(FormatOptions{ListStyle: AndConjunction, Locale: "xx-not-a-locale"})
+	want = "1 year, 2 months and 3 days"
+	if got != want {
+		t.Errorf("FormatWith(Locale: unregistered) = %q, want %q", got, want)
+	}
+}
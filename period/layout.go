@@ -0,0 +1,129 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatLayout converts the period to a string using a strftime-like layout
+// of directives, giving full control over delimiters and padding without
+// having to override the Plurals tables used by Format.
+//
+// Supported directives:
+//
+//	%Y  years            %H  hours
+//	%M  months           %N  minutes
+//	%W  weeks            %S  seconds
+//	%D  days             %f  fractional seconds (3 d.p.)
+//	%%  literal percent
+//
+// Any directive may be zero-padded to two digits by inserting "02", e.g.
+// "%02H". Prefixing a directive with "-" suppresses just that value (the
+// literal text around it is left untouched) when it is zero, e.g. with no
+// years "%-Y y " renders " y ". A leading "%!" at the very start of the
+// layout applies Normalise to the period before rendering.
+//
+// A negative period is rendered with every field non-negative, preceded by
+// a single leading "-", matching the convention used by String.
+//
+// Unrecognised directives result in an error identifying the offending
+// index within layout.
+func (period Period) FormatLayout(layout string) (string, error) {
+	if strings.HasPrefix(layout, "%!") {
+		period = period.Normalise(true)
+		layout = layout[2:]
+	}
+
+	sign := ""
+	if period.Sign() < 0 {
+		sign = "-"
+		period = period.Negate()
+	}
+
+	years, months := period.unpackYM()
+	weeks := period.mdays / 7000
+	days := period.mdays - weeks*7000
+	hours, minutes, seconds := period.unpackHMS()
+	fraction := absFloat1000(period.mseconds % 1000)
+
+	values := map[byte]int{
+		'Y': years,
+		'M': months / 1000,
+		'W': int(weeks),
+		'D': int(days) / 1000,
+		'H': hours,
+		'N': minutes,
+		'S': seconds / 1000,
+	}
+
+	var buf strings.Builder
+	i := 0
+	for i < len(layout) {
+		c := layout[i]
+		if c != '%' {
+			buf.WriteByte(c)
+			i++
+			continue
+		}
+
+		start := i
+		i++
+		if i >= len(layout) {
+			return "", fmt.Errorf("period: invalid layout directive at index %d: trailing %%", start)
+		}
+
+		suppressZero := false
+		if layout[i] == '-' {
+			suppressZero = true
+			i++
+		}
+
+		zeroPad := false
+		if i+1 < len(layout) && layout[i] == '0' && layout[i+1] == '2' {
+			zeroPad = true
+			i += 2
+		}
+
+		if i >= len(layout) {
+			return "", fmt.Errorf("period: invalid layout directive at index %d: incomplete directive", start)
+		}
+
+		directive := layout[i]
+		i++
+
+		if directive == '%' {
+			buf.WriteByte('%')
+			continue
+		}
+
+		if directive == 'f' {
+			if suppressZero && fraction == 0 {
+				continue
+			}
+			buf.WriteString(strconv.FormatFloat(float64(fraction), 'f', 3, 32)[2:])
+			continue
+		}
+
+		value, ok := values[directive]
+		if !ok {
+			return "", fmt.Errorf("period: unrecognised layout directive %%%c at index %d", directive, start)
+		}
+
+		if suppressZero && value == 0 {
+			continue
+		}
+
+		if zeroPad {
+			fmt.Fprintf(&buf, "%02d", value)
+		} else {
+			fmt.Fprintf(&buf, "%d", value)
+		}
+	}
+
+	return sign + buf.String(), nil
+}
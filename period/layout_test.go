@@ -0,0 +1,40 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "testing"
+
+func TestFormatLayout(t *testing.T) {
+	cases := []struct {
+		period Period
+		layout string
+		want   string
+	}{
+		{MustParse("PT1H2M3S"), "%02H:%02N:%02S", "01:02:03"},
+		{MustParse("PT0S"), "%02H:%02N:%02S", "00:00:00"},
+		{MustParse("P1Y2M3D"), "%-Y y %-M mo %-D d", "1 y 2 mo 3 d"},
+		{MustParse("P2M"), "%-Y y %-M mo %-D d", " y 2 mo  d"},
+		{MustParse("PT1H"), "%H%%", "1%"},
+		{MustParse("PT1H2M3S").Negate(), "%02H:%02N:%02S", "-01:02:03"},
+	}
+
+	for _, c := range cases {
+		got, err := c.period.FormatLayout(c.layout)
+		if err != nil {
+			t.Errorf("FormatLayout(%q) on %v: unexpected error: %v", c.layout, c.period, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("FormatLayout(%q) on %v = %q, want %q", c.layout, c.period, got, c.want)
+		}
+	}
+}
+
+func TestFormatLayoutUnrecognisedDirective(t *testing.T) {
+	_, err := MustParse("P1D").FormatLayout("%Q")
+	if err == nil {
+		t.Fatal("expected an error for an unrecognised directive, got nil")
+	}
+}
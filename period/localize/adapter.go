@@ -0,0 +1,119 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localize
+
+import (
+	loc "github.com/go-playground/locales"
+)
+
+// UnitForms holds the translated word or phrase for each CLDR plural
+// category, keyed by the category returned from locales.PluralRule. A
+// category that a given locale never produces (e.g. "Few" in English) may
+// be left blank; pick falls back to Other in that case. "%v" in a
+// form is replaced with the locale-formatted number.
+type UnitForms struct {
+	Zero, One, Two, Few, Many, Other string
+}
+
+func (f UnitForms) pick(rule loc.PluralRule) string {
+	switch rule {
+	case loc.PluralRuleZero:
+		if f.Zero != "" {
+			return f.Zero
+		}
+	case loc.PluralRuleOne:
+		if f.One != "" {
+			return f.One
+		}
+	case loc.PluralRuleTwo:
+		if f.Two != "" {
+			return f.Two
+		}
+	case loc.PluralRuleFew:
+		if f.Few != "" {
+			return f.Few
+		}
+	case loc.PluralRuleMany:
+		if f.Many != "" {
+			return f.Many
+		}
+	}
+	return f.Other
+}
+
+// ListPatterns carries the CLDR "list pattern" fragments used to join unit
+// strings into a single phrase. Each field is a pattern containing the
+// placeholders "{0}" and "{1}", e.g. English's Two: "{0} and {1}".
+type ListPatterns struct {
+	Two, Start, Middle, End string
+}
+
+// UnitTable is the full set of translations needed to localise a period, as
+// consumed by Adapter.
+type UnitTable struct {
+	Year, Month, Week, Day, Hour, Minute, Second UnitForms
+	List                                         ListPatterns
+}
+
+// Adapter turns a go-playground/locales.Translator plus a UnitTable into a
+// Localizer, using the translator's CardinalPluralRule for pluralisation
+// and its FmtNumber for locale-correct decimal separators.
+type Adapter struct {
+	Translator loc.Translator
+	Table      UnitTable
+}
+
+// NewAdapter constructs an Adapter for the given translator and table.
+func NewAdapter(t loc.Translator, table UnitTable) Adapter {
+	return Adapter{Translator: t, Table: table}
+}
+
+func (a Adapter) render(n float64, forms UnitForms) string {
+	form := forms.pick(a.cardinal(n))
+	if form == "" {
+		return ""
+	}
+	return replacePlaceholder(form, a.number(n))
+}
+
+func (a Adapter) cardinal(n float64) loc.PluralRule {
+	_, precision := decimalPrecision(n)
+	return a.Translator.CardinalPluralRule(n, precision)
+}
+
+func (a Adapter) number(n float64) string {
+	_, precision := decimalPrecision(n)
+	return a.Translator.FmtNumber(n, precision)
+}
+
+func (a Adapter) Year(n float64) string   { return a.render(n, a.Table.Year) }
+func (a Adapter) Month(n float64) string  { return a.render(n, a.Table.Month) }
+func (a Adapter) Week(n float64) string   { return a.render(n, a.Table.Week) }
+func (a Adapter) Day(n float64) string    { return a.render(n, a.Table.Day) }
+func (a Adapter) Hour(n float64) string   { return a.render(n, a.Table.Hour) }
+func (a Adapter) Minute(n float64) string { return a.render(n, a.Table.Minute) }
+func (a Adapter) Second(n float64) string { return a.render(n, a.Table.Second) }
+
+// Join applies the locale's CLDR list pattern (start/middle/end/two) to
+// combine the rendered unit strings.
+func (a Adapter) Join(parts []string) string {
+	return joinWithPatterns(parts, a.Table.List)
+}
+
+func joinWithPatterns(parts []string, p ListPatterns) string {
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	case 2:
+		return sprintfPattern(p.Two, parts[0], parts[1])
+	}
+	out := sprintfPattern(p.Start, parts[0], parts[1])
+	for i := 2; i < len(parts)-1; i++ {
+		out = sprintfPattern(p.Middle, out, parts[i])
+	}
+	return sprintfPattern(p.End, out, parts[len(parts)-1])
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package localize provides pluggable, CLDR-aware translations for
+// period.Period, allowing correct pluralisation in locales that need more
+// than the English "0/1/many" pattern (Arabic, Polish, Russian, Welsh, etc).
+package localize
+
+import "sync"
+
+// Localizer supplies the localised words needed to render a period. Each
+// method receives the numeric quantity for that unit and must return the
+// already-pluralised, number-formatted string for it (or "" to omit the
+// unit entirely, mirroring the zero-value behaviour of plural.Plurals).
+type Localizer interface {
+	Year(n float64) string
+	Month(n float64) string
+	Week(n float64) string
+	Day(n float64) string
+	Hour(n float64) string
+	Minute(n float64) string
+	Second(n float64) string
+
+	// Join combines the non-blank unit strings, in order, into the final
+	// rendered period, applying any locale-specific list grammar.
+	Join(parts []string) string
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Localizer{}
+)
+
+// Register makes a Localizer available under the given locale tag (e.g.
+// "en", "fr", "pl"). It is intended to be called from an init function.
+// Registering under a tag that already exists replaces the previous entry.
+func Register(tag string, l Localizer) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[tag] = l
+}
+
+// Lookup returns the Localizer registered for tag, if any.
+func Lookup(tag string) (Localizer, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	l, ok := registry[tag]
+	return l, ok
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localize
+
+import (
+	"testing"
+
+	loc "github.com/go-playground/locales"
+)
+
+func TestUnitFormsPick(t *testing.T) {
+	forms := UnitForms{One: "%v rok", Few: "%v lata", Many: "%v lat", Other: "%v roku"}
+
+	cases := []struct {
+		rule loc.PluralRule
+		want string
+	}{
+		{loc.PluralRuleOne, "%v rok"},
+		{loc.PluralRuleFew, "%v lata"},
+		{loc.PluralRuleMany, "%v lat"},
+		{loc.PluralRuleOther, "%v roku"},
+		{loc.PluralRuleTwo, "%v roku"}, // falls back to Other when Two is blank
+	}
+
+	for _, c := range cases {
+		if got := forms.pick(c.rule); got != c.want {
+			t.Errorf("UnitForms.pick(%v) = %q, want %q", c.rule, got, c.want)
+		}
+	}
+}
+
+func TestJoinWithPatterns(t *testing.T) {
+	patterns := ListPatterns{
+		Two:    "{0} and {1}",
+		Start:  "{0}, {1}",
+		Middle: "{0}, {1}",
+		End:    "{0} and {1}",
+	}
+
+	cases := []struct {
+		parts []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"1 year"}, "1 year"},
+		{[]string{"1 year", "2 months"}, "1 year and 2 months"},
+		{[]string{"1 year", "2 months", "3 days"}, "1 year, 2 months and 3 days"},
+		{[]string{"1y", "2mo", "3d", "4h"}, "1y, 2mo, 3d and 4h"},
+	}
+
+	for _, c := range cases {
+		if got := joinWithPatterns(c.parts, patterns); got != c.want {
+			t.Errorf("joinWithPatterns(%v) = %q, want %q", c.parts, got, c.want)
+		}
+	}
+}
+
+func TestRegisterAndLookup(t *testing.T) {
+	if _, ok := Lookup("en"); !ok {
+		t.Fatal(`Lookup("en") expected the built-in English localizer to be registered`)
+	}
+	if _, ok := Lookup("xx-not-a-locale"); ok {
+		t.Error(`Lookup("xx-not-a-locale") expected no localizer to be registered`)
+	}
+}
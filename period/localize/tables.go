@@ -0,0 +1,105 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localize
+
+import (
+	"github.com/go-playground/locales/ar"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/pl"
+	"github.com/go-playground/locales/ru"
+)
+
+func init() {
+	Register("en", NewAdapter(en.New(), enTable))
+	Register("fr", NewAdapter(fr.New(), frTable))
+	Register("de", NewAdapter(de.New(), deTable))
+	Register("es", NewAdapter(es.New(), esTable))
+	Register("pl", NewAdapter(pl.New(), plTable))
+	Register("ru", NewAdapter(ru.New(), ruTable))
+	Register("ar", NewAdapter(ar.New(), arTable))
+}
+
+var enTable = UnitTable{
+	Year:   UnitForms{One: "%v year", Other: "%v years"},
+	Month:  UnitForms{One: "%v month", Other: "%v months"},
+	Week:   UnitForms{One: "%v week", Other: "%v weeks"},
+	Day:    UnitForms{One: "%v day", Other: "%v days"},
+	Hour:   UnitForms{One: "%v hour", Other: "%v hours"},
+	Minute: UnitForms{One: "%v minute", Other: "%v minutes"},
+	Second: UnitForms{One: "%v second", Other: "%v seconds"},
+	List:   ListPatterns{Two: "{0} and {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} and {1}"},
+}
+
+var frTable = UnitTable{
+	Year:   UnitForms{One: "%v an", Other: "%v ans"},
+	Month:  UnitForms{One: "%v mois", Other: "%v mois"},
+	Week:   UnitForms{One: "%v semaine", Other: "%v semaines"},
+	Day:    UnitForms{One: "%v jour", Other: "%v jours"},
+	Hour:   UnitForms{One: "%v heure", Other: "%v heures"},
+	Minute: UnitForms{One: "%v minute", Other: "%v minutes"},
+	Second: UnitForms{One: "%v seconde", Other: "%v secondes"},
+	List:   ListPatterns{Two: "{0} et {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} et {1}"},
+}
+
+var deTable = UnitTable{
+	Year:   UnitForms{One: "%v Jahr", Other: "%v Jahre"},
+	Month:  UnitForms{One: "%v Monat", Other: "%v Monate"},
+	Week:   UnitForms{One: "%v Woche", Other: "%v Wochen"},
+	Day:    UnitForms{One: "%v Tag", Other: "%v Tage"},
+	Hour:   UnitForms{One: "%v Stunde", Other: "%v Stunden"},
+	Minute: UnitForms{One: "%v Minute", Other: "%v Minuten"},
+	Second: UnitForms{One: "%v Sekunde", Other: "%v Sekunden"},
+	List:   ListPatterns{Two: "{0} und {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}"},
+}
+
+var esTable = UnitTable{
+	Year:   UnitForms{One: "%v año", Other: "%v años"},
+	Month:  UnitForms{One: "%v mes", Other: "%v meses"},
+	Week:   UnitForms{One: "%v semana", Other: "%v semanas"},
+	Day:    UnitForms{One: "%v día", Other: "%v días"},
+	Hour:   UnitForms{One: "%v hora", Other: "%v horas"},
+	Minute: UnitForms{One: "%v minuto", Other: "%v minutos"},
+	Second: UnitForms{One: "%v segundo", Other: "%v segundos"},
+	List:   ListPatterns{Two: "{0} y {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} y {1}"},
+}
+
+// Polish distinguishes One (1), Few (2-4, not 12-14) and Many (0, 5-21, ...).
+var plTable = UnitTable{
+	Year:   UnitForms{One: "%v rok", Few: "%v lata", Many: "%v lat", Other: "%v roku"},
+	Month:  UnitForms{One: "%v miesiąc", Few: "%v miesiące", Many: "%v miesięcy", Other: "%v miesiąca"},
+	Week:   UnitForms{One: "%v tydzień", Few: "%v tygodnie", Many: "%v tygodni", Other: "%v tygodnia"},
+	Day:    UnitForms{One: "%v dzień", Few: "%v dni", Many: "%v dni", Other: "%v dnia"},
+	Hour:   UnitForms{One: "%v godzina", Few: "%v godziny", Many: "%v godzin", Other: "%v godziny"},
+	Minute: UnitForms{One: "%v minuta", Few: "%v minuty", Many: "%v minut", Other: "%v minuty"},
+	Second: UnitForms{One: "%v sekunda", Few: "%v sekundy", Many: "%v sekund", Other: "%v sekundy"},
+	List:   ListPatterns{Two: "{0} i {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}"},
+}
+
+// Russian distinguishes One, Few (2-4) and Many (0, 5-20, ...) by n mod 10 / n mod 100.
+var ruTable = UnitTable{
+	Year:   UnitForms{One: "%v год", Few: "%v года", Many: "%v лет", Other: "%v года"},
+	Month:  UnitForms{One: "%v месяц", Few: "%v месяца", Many: "%v месяцев", Other: "%v месяца"},
+	Week:   UnitForms{One: "%v неделя", Few: "%v недели", Many: "%v недель", Other: "%v недели"},
+	Day:    UnitForms{One: "%v день", Few: "%v дня", Many: "%v дней", Other: "%v дня"},
+	Hour:   UnitForms{One: "%v час", Few: "%v часа", Many: "%v часов", Other: "%v часа"},
+	Minute: UnitForms{One: "%v минута", Few: "%v минуты", Many: "%v минут", Other: "%v минуты"},
+	Second: UnitForms{One: "%v секунда", Few: "%v секунды", Many: "%v секунд", Other: "%v секунды"},
+	List:   ListPatterns{Two: "{0} и {1}", Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} и {1}"},
+}
+
+// Arabic has all six CLDR categories (Zero, One, Two, Few, Many, Other).
+var arTable = UnitTable{
+	Year:   UnitForms{Zero: "%v سنة", One: "سنة واحدة", Two: "سنتان", Few: "%v سنوات", Many: "%v سنة", Other: "%v سنة"},
+	Month:  UnitForms{Zero: "%v شهر", One: "شهر واحد", Two: "شهران", Few: "%v أشهر", Many: "%v شهرًا", Other: "%v شهر"},
+	Week:   UnitForms{Zero: "%v أسبوع", One: "أسبوع واحد", Two: "أسبوعان", Few: "%v أسابيع", Many: "%v أسبوعًا", Other: "%v أسبوع"},
+	Day:    UnitForms{Zero: "%v يوم", One: "يوم واحد", Two: "يومان", Few: "%v أيام", Many: "%v يومًا", Other: "%v يوم"},
+	Hour:   UnitForms{Zero: "%v ساعة", One: "ساعة واحدة", Two: "ساعتان", Few: "%v ساعات", Many: "%v ساعة", Other: "%v ساعة"},
+	Minute: UnitForms{Zero: "%v دقيقة", One: "دقيقة واحدة", Two: "دقيقتان", Few: "%v دقائق", Many: "%v دقيقة", Other: "%v دقيقة"},
+	Second: UnitForms{Zero: "%v ثانية", One: "ثانية واحدة", Two: "ثانيتان", Few: "%v ثوانٍ", Many: "%v ثانية", Other: "%v ثانية"},
+	List:   ListPatterns{Two: "{0} و{1}", Start: "{0}، {1}", Middle: "{0}، {1}", End: "{0} و{1}"},
+}
@@ -0,0 +1,34 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package localize
+
+import (
+	"strconv"
+	"strings"
+)
+
+// decimalPrecision returns n itself along with the number of decimal digits
+// it needs (periods carry millisecond precision, so at most 3), matching the
+// "v" (visible fraction digit count) parameter locales.Translator expects.
+func decimalPrecision(n float64) (float64, uint64) {
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	var precision uint64
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		precision = uint64(len(s) - i - 1)
+	}
+	return n, precision
+}
+
+// replacePlaceholder substitutes the first "%v" in form with value.
+func replacePlaceholder(form, value string) string {
+	return strings.Replace(form, "%v", value, 1)
+}
+
+// sprintfPattern applies a CLDR list pattern such as "{0} and {1}".
+func sprintfPattern(pattern, a, b string) string {
+	pattern = strings.Replace(pattern, "{0}", a, 1)
+	pattern = strings.Replace(pattern, "{1}", b, 1)
+	return pattern
+}
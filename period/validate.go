@@ -0,0 +1,158 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MustParse is as per Parse except that it panics if the string cannot be
+// parsed. This is intended for setup code that must succeed, such as
+// initialising package-level variables from string literals.
+func MustParse(isoPeriod string) Period {
+	period, err := Parse(isoPeriod)
+	if err != nil {
+		panic(err)
+	}
+	return period
+}
+
+// ParseStrict is as per Parse except that it additionally rejects periods
+// that mix a week component with any other date component (years, months
+// or days), which ISO 8601 §4.4.3.2 disallows.
+//
+// Parse itself merges a parsed "W" designator straight into the same mdays
+// field used for "D", so that field alone can no longer tell a
+// week-designated period apart from an ordinary one by the time it has been
+// parsed - "P1Y7D" and "P1W" can end up with the same mdays. So this checks
+// the designators actually present in isoPeriod's date section (the part
+// before "T", if any) directly, rather than guessing from the parsed Period.
+func ParseStrict(isoPeriod string) (Period, error) {
+	period, err := Parse(isoPeriod)
+	if err != nil {
+		return period, err
+	}
+
+	datePart := isoPeriod
+	if len(datePart) > 0 && (datePart[0] == '+' || datePart[0] == '-') {
+		datePart = datePart[1:]
+	}
+	datePart = strings.TrimPrefix(datePart, "P")
+	if idx := strings.IndexByte(datePart, 'T'); idx >= 0 {
+		datePart = datePart[:idx]
+	}
+
+	if strings.ContainsRune(datePart, 'W') && strings.ContainsAny(datePart, "YMD") {
+		return Period{}, fmt.Errorf("period: %q mixes a week designator with year/month/day designators, which ISO 8601 §4.4.3.2 disallows", isoPeriod)
+	}
+
+	return period, nil
+}
+
+// ValidDuration checks that s can be parsed as an ISO 8601 period. It
+// returns nil if so, otherwise the parse error. This is intended for use as
+// a validation function in config-loading and CLI-flag tooling, where a
+// plain error (rather than a Period and an error) is the expected shape.
+func ValidDuration(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// Between returns a validation function that checks a string parses as a
+// Period lying within [min, max] inclusive, comparing durations via
+// DurationApprox. The returned function is suitable for the same
+// validate-a-string call sites as ValidDuration.
+func Between(min, max Period) func(string) error {
+	return func(s string) error {
+		p, err := Parse(s)
+		if err != nil {
+			return err
+		}
+
+		d := p.DurationApprox()
+		if d < min.DurationApprox() || d > max.DurationApprox() {
+			return fmt.Errorf("expected duration between %s and %s, got %s", min, max, p)
+		}
+
+		return nil
+	}
+}
+
+const dateComponentLetters = "YMWD"
+const timeComponentLetters = "HNS"
+
+// MatchesPattern returns a validation function that checks a string parses
+// as a Period containing only the components listed in mask.
+//
+// mask is either a bare designator - "P" for all date components (years,
+// months, weeks, days) or "T"/"PT" for all time components (hours,
+// minutes, seconds) - or a designator followed by specific component
+// letters drawn from "YMWD" (date) and "HNS" (time), such as "PYMD" to
+// permit only years, months and days, or "PTH" to permit only hours.
+func MatchesPattern(mask string) func(string) error {
+	dateLetters := lettersIn(mask, dateComponentLetters)
+	timeLetters := lettersIn(mask, timeComponentLetters)
+
+	allowDate := len(dateLetters) > 0 || (strings.ContainsRune(mask, 'P') && !strings.ContainsRune(mask, 'T'))
+	allowTime := len(timeLetters) > 0 || strings.ContainsRune(mask, 'T')
+
+	return func(s string) error {
+		p, err := Parse(s)
+		if err != nil {
+			return err
+		}
+
+		years, months := p.unpackYM()
+		weeks := p.mdays / 7000
+		days := p.mdays % 7000
+		if !strings.ContainsRune(s, 'W') {
+			// Parse folds "W" into mdays, so without a week designator in s
+			// all of mdays was given as days.
+			weeks, days = 0, p.mdays
+		}
+		hours, minutes, seconds := p.unpackHMS()
+
+		violations := make([]string, 0)
+		check := func(letter byte, groupAllowed bool, allowedLetters string, value int, name string) {
+			if value == 0 {
+				return
+			}
+			if !groupAllowed {
+				violations = append(violations, name)
+				return
+			}
+			if len(allowedLetters) > 0 && !strings.ContainsRune(allowedLetters, rune(letter)) {
+				violations = append(violations, name)
+			}
+		}
+
+		check('Y', allowDate, dateLetters, years, "years")
+		check('M', allowDate, dateLetters, months, "months")
+		check('W', allowDate, dateLetters, int(weeks), "weeks")
+		check('D', allowDate, dateLetters, int(days), "days")
+		check('H', allowTime, timeLetters, hours, "hours")
+		check('N', allowTime, timeLetters, minutes, "minutes")
+		check('S', allowTime, timeLetters, seconds, "seconds")
+
+		if len(violations) > 0 {
+			return fmt.Errorf("period: %q contains components (%s) not permitted by mask %q", s, strings.Join(violations, ", "), mask)
+		}
+
+		return nil
+	}
+}
+
+// lettersIn returns the subset of alphabet present in mask, preserving
+// alphabet's order.
+func lettersIn(mask, alphabet string) string {
+	var found strings.Builder
+	for i := 0; i < len(alphabet); i++ {
+		if strings.IndexByte(mask, alphabet[i]) >= 0 {
+			found.WriteByte(alphabet[i])
+		}
+	}
+	return found.String()
+}
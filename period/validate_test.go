@@ -0,0 +1,69 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "testing"
+
+func TestParseStrict(t *testing.T) {
+	valid := []string{"P1Y7D", "P1W", "P1Y2M3D", "PT1H"}
+	for _, s := range valid {
+		if _, err := ParseStrict(s); err != nil {
+			t.Errorf("ParseStrict(%q) returned unexpected error: %v", s, err)
+		}
+	}
+
+	invalid := []string{"P1W3D", "P1Y1W", "P1W2M"}
+	for _, s := range invalid {
+		if _, err := ParseStrict(s); err == nil {
+			t.Errorf("ParseStrict(%q) expected an error for mixing week and date designators, got nil", s)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	validate := Between(MustParse("P1D"), MustParse("P30D"))
+
+	if err := validate("P10D"); err != nil {
+		t.Errorf("Between: unexpected error for in-range value: %v", err)
+	}
+	if err := validate("P1D"); err != nil {
+		t.Errorf("Between: unexpected error for lower-bound value: %v", err)
+	}
+	if err := validate("P30D"); err != nil {
+		t.Errorf("Between: unexpected error for upper-bound value: %v", err)
+	}
+	if err := validate("PT12H"); err == nil {
+		t.Error("Between: expected an error for below-range value, got nil")
+	}
+	if err := validate("P31D"); err == nil {
+		t.Error("Between: expected an error for above-range value, got nil")
+	}
+}
+
+func TestMatchesPattern(t *testing.T) {
+	onlyTime := MatchesPattern("PT")
+	if err := onlyTime("PT1H30M"); err != nil {
+		t.Errorf("MatchesPattern(\"PT\")(\"PT1H30M\") unexpected error: %v", err)
+	}
+	if err := onlyTime("P1D"); err == nil {
+		t.Error("MatchesPattern(\"PT\")(\"P1D\") expected an error, got nil")
+	}
+
+	onlyDate := MatchesPattern("P")
+	if err := onlyDate("P1Y2M3D"); err != nil {
+		t.Errorf("MatchesPattern(\"P\")(\"P1Y2M3D\") unexpected error: %v", err)
+	}
+	if err := onlyDate("PT1H"); err == nil {
+		t.Error("MatchesPattern(\"P\")(\"PT1H\") expected an error, got nil")
+	}
+
+	ymdOnly := MatchesPattern("PYMD")
+	if err := ymdOnly("P1Y2M3D"); err != nil {
+		t.Errorf("MatchesPattern(\"PYMD\")(\"P1Y2M3D\") unexpected error: %v", err)
+	}
+	if err := ymdOnly("P1W"); err == nil {
+		t.Error("MatchesPattern(\"PYMD\")(\"P1W\") expected an error for a week component, got nil")
+	}
+}